@@ -30,21 +30,83 @@ type NamespaceClassSpec struct {
 	// Important: Run "make" to regenerate code after modifying this file
 
 	// Resources is a list of Kubernetes objects that should be created when a namespace of this class is created.
-	//+kubebuilder:validation:MinItems=1
-	Resources []runtime.RawExtension `json:"resources"`
+	// It may be empty for a class that only composes parents via Extends and adds nothing of its own.
+	//+optional
+	Resources []runtime.RawExtension `json:"resources,omitempty"`
+
+	// Extends lists the names of parent NamespaceClasses this class composes with. Parents are
+	// resolved before this class, and this class's Resources override a parent's on a matching
+	// GVK+name, so layering "base" + "team-a" + "pci-compliant" only requires each layer to define
+	// what it adds or changes.
+	//+optional
+	Extends []string `json:"extends,omitempty"`
 }
 
 // NamespaceClassStatus defines the observed state of NamespaceClass
 type NamespaceClassStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
-	Resources []ResourceStatus `json:"resources"`
+	// ResolvedResources is the flattened set of resources this NamespaceClass resolves to once its
+	// Extends chain is walked and merged, identifying each entry by group, version, kind and name,
+	// with Namespace left empty. It reflects the class's own composition and is independent of which namespaces are bound to it.
+	//+optional
+	ResolvedResources []ResourceStatus `json:"resolvedResources,omitempty"`
+
+	// BoundNamespaces is the number of namespaces currently bound to this NamespaceClass, as
+	// reported by their NamespaceClassBindings. Each namespace's own managed resources are recorded
+	// on its binding, not here, so that many namespaces reconciling concurrently never contend on
+	// this status.
+	//+optional
+	BoundNamespaces int `json:"boundNamespaces,omitempty"`
+
+	// NamespaceSummaries reports, per namespace bound to this class, how many resources it manages
+	// and whether its own binding is Ready. It is aggregated from NamespaceClassBindings.
+	//+optional
+	NamespaceSummaries []NamespaceSummary `json:"namespaceSummaries,omitempty"`
+
+	// ObservedGeneration is the most recent Spec generation the controller has acted on.
+	//+optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the NamespaceClass's state,
+	// aggregated across every bound namespace's NamespaceClassBinding. Known condition types are
+	// Ready, ResourcesApplied and ResourcesPruned.
+	//+optional
+	//+patchMergeKey=type
+	//+patchStrategy=merge
+	//+listType=map
+	//+listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// NamespaceSummary reports one bound namespace's view of a NamespaceClass.
+type NamespaceSummary struct {
+	// Namespace is the name of the bound namespace.
+	Namespace string `json:"namespace"`
+	// ResourceCount is the number of resources this namespace's binding currently manages.
+	ResourceCount int `json:"resourceCount"`
+	// Ready mirrors the Ready condition of this namespace's NamespaceClassBinding.
+	Ready bool `json:"ready"`
 }
 
+const (
+	// ConditionTypeReady indicates whether the NamespaceClass's resources are fully reconciled.
+	ConditionTypeReady = "Ready"
+	// ConditionTypeResourcesApplied indicates whether the latest apply of the resolved resource set
+	// (Spec.Resources merged with everything inherited through Extends) succeeded.
+	ConditionTypeResourcesApplied = "ResourcesApplied"
+	// ConditionTypeResourcesPruned indicates whether resources no longer in the resolved resource
+	// set have been deleted.
+	ConditionTypeResourcesPruned = "ResourcesPruned"
+)
+
+// ResourceStatus identifies a single resource managed by a NamespaceClass. Group, Version, Kind,
+// Namespace and Name together form the unique key used to match a live object back to its status
+// entry, so that two resources of the same Kind (e.g. two NetworkPolicies) are never conflated.
 type ResourceStatus struct {
-	Kind       string `json:"kind"`
-	Name       string `json:"name"`
-	APIVersion string `json:"apiVersion"`
+	Group     string `json:"group,omitempty"`
+	Version   string `json:"version"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
 }
 
 //+kubebuilder:object:root=true
@@ -0,0 +1,76 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NamespaceClassBindingSpec defines the desired state of NamespaceClassBinding
+type NamespaceClassBindingSpec struct {
+	// ClassName is the name of the NamespaceClass this namespace composes resources from.
+	ClassName string `json:"className"`
+}
+
+// NamespaceClassBindingStatus defines the observed state of NamespaceClassBinding
+type NamespaceClassBindingStatus struct {
+	// Resources is the set of live resources this namespace's binding currently manages.
+	//+optional
+	Resources []ResourceStatus `json:"resources,omitempty"`
+
+	// ObservedGeneration is the most recent Spec generation the controller has acted on.
+	//+optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of this namespace's own reconcile
+	// against its NamespaceClass. Known condition types are Ready, ResourcesApplied and
+	// ResourcesPruned.
+	//+optional
+	//+patchMergeKey=type
+	//+patchStrategy=merge
+	//+listType=map
+	//+listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// NamespaceClassBinding is the Schema for the namespaceclassbindings API. One binding exists per
+// namespace bound to a NamespaceClass, recording that namespace's own managed resources so that
+// many namespaces reconciling the same NamespaceClass concurrently never contend on a single
+// shared status object.
+type NamespaceClassBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NamespaceClassBindingSpec   `json:"spec,omitempty"`
+	Status NamespaceClassBindingStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// NamespaceClassBindingList contains a list of NamespaceClassBinding
+type NamespaceClassBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NamespaceClassBinding `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NamespaceClassBinding{}, &NamespaceClassBindingList{})
+}
@@ -2,12 +2,18 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -16,16 +22,76 @@ import (
 	akuityiov1 "github.com/anubhav06/nsc-controller/api/v1"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
 
+// namespaceClassFinalizer is installed on both Namespaces bound to a NamespaceClass and on the
+// NamespaceClass itself, so that deleting either side tears down the resources it manages instead
+// of leaking them.
+const namespaceClassFinalizer = "namespaceclass.akuity.io/finalizer"
+
+// fieldManager is the stable server-side apply field manager used for every resource this
+// controller creates, so repeated applies are recognized as the same owner of those fields.
+const fieldManager = "nsc-controller"
+
+// managedByLabel records, on every resource the controller manages, which NamespaceClass it came
+// from.
+const managedByLabel = "namespaceclass.akuity.io/managed-by"
+
+// namespaceClassBindingName is the fixed name of the single NamespaceClassBinding a namespace
+// owns, since a namespace is only ever bound to one (possibly composed) NamespaceClass at a time.
+const namespaceClassBindingName = "namespaceclass"
+
 type NamespaceReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// NamespaceSelector restricts reconciliation to namespaces matching this selector. A nil or
+	// empty selector matches everything only when WatchedNamespaces is also empty; otherwise it
+	// matches nothing and only the allowlisted names are in scope.
+	NamespaceSelector labels.Selector
+	// WatchedNamespaces is an allowlist of namespace names this controller owns, in addition to
+	// whatever matches NamespaceSelector. An empty list imposes no additional restriction.
+	WatchedNamespaces []string
+
+	// Recorder emits Kubernetes Events on the Namespace and NamespaceClass involved in a
+	// reconcile, so operators can see failures via `kubectl describe`.
+	Recorder record.EventRecorder
+}
+
+// inScope reports whether a namespace should be reconciled by this controller instance, letting
+// multiple nsc-controller deployments own disjoint namespace sets in the same cluster.
+func (r *NamespaceReconciler) inScope(namespace *corev1.Namespace) bool {
+	hasSelector := r.NamespaceSelector != nil && !r.NamespaceSelector.Empty()
+	hasAllowlist := len(r.WatchedNamespaces) > 0
+
+	if !hasSelector && !hasAllowlist {
+		return true
+	}
+	if hasSelector && r.NamespaceSelector.Matches(labels.Set(namespace.Labels)) {
+		return true
+	}
+	for _, name := range r.WatchedNamespaces {
+		if name == namespace.Name {
+			return true
+		}
+	}
+	return false
 }
 
 //+kubebuilder:rbac:groups=akuity.io.my.domain,resources=namespaces,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=akuity.io.my.domain,resources=namespaces/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=akuity.io.my.domain,resources=namespaces/finalizers,verbs=update
+//+kubebuilder:rbac:groups=akuity.io.my.domain,resources=namespaceclasses,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=akuity.io.my.domain,resources=namespaceclasses/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=akuity.io.my.domain,resources=namespaceclasses/finalizers,verbs=update
+//+kubebuilder:rbac:groups=akuity.io.my.domain,resources=namespaceclassbindings,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=akuity.io.my.domain,resources=namespaceclassbindings/status,verbs=get;update;patch
 
 func (r *NamespaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 
@@ -36,28 +102,290 @@ func (r *NamespaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{}, err
 	}
 
+	if !r.inScope(namespace) {
+		log.Info("Namespace is out of scope for this controller", "namespace", req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+
+	if !namespace.DeletionTimestamp.IsZero() {
+		return r.finalizeNamespace(ctx, namespace, log)
+	}
+
 	classLabel, ok := namespace.Labels["namespaceclass.akuity.io/name"]
 	if !ok {
 		log.Info("Namespace does not have a class label", "namespace", req.NamespacedName)
 		return ctrl.Result{}, nil
 	}
 
+	classNames := splitClassNames(classLabel)
+	if len(classNames) == 0 {
+		log.Info("Namespace class label is empty", "namespace", req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+	primaryClassName := classNames[len(classNames)-1]
+
+	if controllerutil.AddFinalizer(namespace, namespaceClassFinalizer) {
+		if err := r.Update(ctx, namespace); err != nil {
+			log.Error(err, "Failed to add finalizer to namespace", "namespace", namespace.Name)
+			return ctrl.Result{}, err
+		}
+	}
+
 	namespaceClass := &akuityiov1.NamespaceClass{}
-	if err := r.fetchNamespaceClass(ctx, classLabel, namespaceClass, log); err != nil {
+	if err := r.fetchNamespaceClass(ctx, primaryClassName, namespaceClass, log); err != nil {
 		return ctrl.Result{}, err
 	}
+	if namespaceClass.Name == "" {
+		log.Info("NamespaceClass does not exist", "namespaceclass", primaryClassName)
+		return ctrl.Result{}, nil
+	}
+
+	if !namespaceClass.DeletionTimestamp.IsZero() {
+		return r.finalizeNamespaceClass(ctx, namespaceClass, log)
+	}
 
-	if err := r.handleResources(ctx, namespace, namespaceClass, log); err != nil {
+	if err := r.ensureClassFinalizers(ctx, classNames, log); err != nil {
 		return ctrl.Result{}, err
 	}
 
-	if err := r.handleNamespaceAnnotations(ctx, namespace, namespaceClass, log); err != nil {
+	binding, err := r.fetchOrCreateBinding(ctx, namespace, primaryClassName, log)
+	if err != nil {
 		return ctrl.Result{}, err
 	}
 
+	bindingBase := client.MergeFrom(binding.DeepCopy())
+
+	var resourcesErr, annotationsErr error
+	resolvedResources, resolveErr := r.resolveEffectiveResources(ctx, classNames, log)
+	if resolveErr != nil {
+		log.Error(resolveErr, "Failed to resolve NamespaceClass inheritance", "namespaceclass", primaryClassName)
+		r.Recorder.Eventf(namespaceClass, corev1.EventTypeWarning, "ResolveFailed", "Failed to resolve NamespaceClass inheritance: %v", resolveErr)
+		r.Recorder.Eventf(namespace, corev1.EventTypeWarning, "ResolveFailed", "Failed to resolve inheritance for NamespaceClass %q: %v", primaryClassName, resolveErr)
+		resourcesErr = resolveErr
+	} else {
+		resourcesErr = r.handleResources(ctx, namespace, namespaceClass, binding, resolvedResources, log)
+		annotationsErr = r.handleNamespaceAnnotations(ctx, namespace, classLabel, namespaceClass, binding, resolvedResources, log)
+	}
+
+	// NamespaceClass.Status (ResolvedResources, ObservedGeneration, the aggregate Conditions) is
+	// owned entirely by NamespaceClassReconciler, which recomputes it straight from Spec on class
+	// changes. Writing it here too, from every namespace's reconcile, is exactly the cross-object
+	// write contention this binding was introduced to eliminate.
+	if err := r.updateBindingConditions(ctx, binding, bindingBase, resourcesErr, annotationsErr); err != nil {
+		log.Error(err, "Failed to patch namespace class binding conditions", "binding", binding.Name, "namespace", namespace.Name)
+		return ctrl.Result{}, err
+	}
+
+	if resourcesErr != nil {
+		return ctrl.Result{}, resourcesErr
+	}
+	if annotationsErr != nil {
+		return ctrl.Result{}, annotationsErr
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// fetchOrCreateBinding gets the namespace's NamespaceClassBinding, creating it if it doesn't exist
+// yet, and keeps its Spec.ClassName in sync with the namespace's current class label.
+func (r *NamespaceReconciler) fetchOrCreateBinding(ctx context.Context, namespace *corev1.Namespace, primaryClassName string, log logr.Logger) (*akuityiov1.NamespaceClassBinding, error) {
+	binding := &akuityiov1.NamespaceClassBinding{}
+	key := client.ObjectKey{Namespace: namespace.Name, Name: namespaceClassBindingName}
+	err := r.Get(ctx, key, binding)
+	switch {
+	case errors.IsNotFound(err):
+		binding = &akuityiov1.NamespaceClassBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace.Name,
+				Name:      namespaceClassBindingName,
+			},
+			Spec: akuityiov1.NamespaceClassBindingSpec{ClassName: primaryClassName},
+		}
+		if err := r.Create(ctx, binding); err != nil {
+			log.Error(err, "Failed to create namespace class binding", "namespace", namespace.Name)
+			return nil, err
+		}
+		return binding, nil
+	case err != nil:
+		log.Error(err, "Failed to get namespace class binding", "namespace", namespace.Name)
+		return nil, err
+	}
+
+	if binding.Spec.ClassName != primaryClassName {
+		binding.Spec.ClassName = primaryClassName
+		if err := r.Update(ctx, binding); err != nil {
+			log.Error(err, "Failed to update namespace class binding", "namespace", namespace.Name)
+			return nil, err
+		}
+	}
+	return binding, nil
+}
+
+// updateBindingConditions patches the NamespaceClassBinding's Status.Conditions and
+// ObservedGeneration to reflect the outcome of this reconcile.
+func (r *NamespaceReconciler) updateBindingConditions(ctx context.Context, binding *akuityiov1.NamespaceClassBinding, base client.Patch, resourcesErr, annotationsErr error) error {
+	binding.Status.ObservedGeneration = binding.Generation
+
+	resourcesCondition := metav1.Condition{
+		Type:               akuityiov1.ConditionTypeResourcesApplied,
+		Status:             metav1.ConditionTrue,
+		Reason:             "ResourcesApplied",
+		Message:            "All resources in the NamespaceClass were applied successfully",
+		ObservedGeneration: binding.Generation,
+	}
+	if resourcesErr != nil {
+		resourcesCondition.Status = metav1.ConditionFalse
+		resourcesCondition.Reason = "ResourcesApplyFailed"
+		resourcesCondition.Message = resourcesErr.Error()
+	}
+	apimeta.SetStatusCondition(&binding.Status.Conditions, resourcesCondition)
+
+	prunedCondition := metav1.Condition{
+		Type:               akuityiov1.ConditionTypeResourcesPruned,
+		Status:             metav1.ConditionTrue,
+		Reason:             "ResourcesPruned",
+		Message:            "No stale resources remain",
+		ObservedGeneration: binding.Generation,
+	}
+	if annotationsErr != nil {
+		prunedCondition.Status = metav1.ConditionFalse
+		prunedCondition.Reason = "ResourcesPruneFailed"
+		prunedCondition.Message = annotationsErr.Error()
+	}
+	apimeta.SetStatusCondition(&binding.Status.Conditions, prunedCondition)
+
+	readyCondition := metav1.Condition{
+		Type:               akuityiov1.ConditionTypeReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Ready",
+		Message:            "Namespace is fully reconciled against its NamespaceClass",
+		ObservedGeneration: binding.Generation,
+	}
+	if resourcesErr != nil || annotationsErr != nil {
+		readyCondition.Status = metav1.ConditionFalse
+		readyCondition.Reason = "ReconcileFailed"
+		readyCondition.Message = "One or more resources failed to reconcile"
+	}
+	apimeta.SetStatusCondition(&binding.Status.Conditions, readyCondition)
+
+	return r.Status().Patch(ctx, binding, base)
+}
+
+// finalizeNamespace tears down every resource tracked against the namespace's NamespaceClass and
+// releases the finalizer once they are all gone.
+func (r *NamespaceReconciler) finalizeNamespace(ctx context.Context, namespace *corev1.Namespace, log logr.Logger) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(namespace, namespaceClassFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if classLabel, ok := namespace.Labels["namespaceclass.akuity.io/name"]; ok {
+		classNames := splitClassNames(classLabel)
+		if len(classNames) > 0 {
+			namespaceClass := &akuityiov1.NamespaceClass{}
+			if err := r.fetchNamespaceClass(ctx, classNames[len(classNames)-1], namespaceClass, log); err != nil {
+				return ctrl.Result{}, err
+			}
+
+			binding := &akuityiov1.NamespaceClassBinding{}
+			bindingKey := client.ObjectKey{Namespace: namespace.Name, Name: namespaceClassBindingName}
+			if err := r.Get(ctx, bindingKey, binding); err != nil && !errors.IsNotFound(err) {
+				log.Error(err, "Failed to get namespace class binding", "namespace", namespace.Name)
+				return ctrl.Result{}, err
+			} else if err == nil {
+				if err := r.deleteTrackedResources(ctx, namespace, namespaceClass, binding.Status.Resources, log); err != nil {
+					return ctrl.Result{}, err
+				}
+				if err := r.Delete(ctx, binding); err != nil && !errors.IsNotFound(err) {
+					log.Error(err, "Failed to delete namespace class binding", "namespace", namespace.Name)
+					return ctrl.Result{}, err
+				}
+			}
+		}
+	}
+
+	controllerutil.RemoveFinalizer(namespace, namespaceClassFinalizer)
+	if err := r.Update(ctx, namespace); err != nil {
+		log.Error(err, "Failed to remove finalizer from namespace", "namespace", namespace.Name)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// finalizeNamespaceClass tears down, in every namespace still depending on this class - whether as
+// their primary class or transitively through some class's Extends - every resource tracked in
+// that namespace's NamespaceClassBinding (including ones that came from other classes in its
+// composition), deletes the binding, and releases the finalizer once they are all gone.
+func (r *NamespaceReconciler) finalizeNamespaceClass(ctx context.Context, namespaceClass *akuityiov1.NamespaceClass, log logr.Logger) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(namespaceClass, namespaceClassFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	var namespaces corev1.NamespaceList
+	if err := r.List(ctx, &namespaces); err != nil {
+		log.Error(err, "Failed to list namespaces for namespace class", "namespaceclass", namespaceClass.Name)
+		return ctrl.Result{}, err
+	}
+
+	for i := range namespaces.Items {
+		ns := &namespaces.Items[i]
+		depends, err := r.namespaceDependsOnClass(ctx, ns, namespaceClass.Name, log)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !depends {
+			continue
+		}
+
+		binding := &akuityiov1.NamespaceClassBinding{}
+		bindingKey := client.ObjectKey{Namespace: ns.Name, Name: namespaceClassBindingName}
+		if err := r.Get(ctx, bindingKey, binding); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			log.Error(err, "Failed to get namespace class binding", "namespace", ns.Name)
+			return ctrl.Result{}, err
+		}
+
+		if err := r.deleteTrackedResources(ctx, ns, namespaceClass, binding.Status.Resources, log); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := r.Delete(ctx, binding); err != nil && !errors.IsNotFound(err) {
+			log.Error(err, "Failed to delete namespace class binding", "namespace", ns.Name)
+			return ctrl.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(namespaceClass, namespaceClassFinalizer)
+	if err := r.Update(ctx, namespaceClass); err != nil {
+		log.Error(err, "Failed to remove finalizer from namespace class", "namespaceclass", namespaceClass.Name)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// deleteTrackedResources deletes every resource in resources (the entries recorded in the given
+// namespace's NamespaceClassBinding status), tolerating resources that are already gone.
+func (r *NamespaceReconciler) deleteTrackedResources(ctx context.Context, namespace *corev1.Namespace, namespaceClass *akuityiov1.NamespaceClass, resources []akuityiov1.ResourceStatus, log logr.Logger) error {
+	for _, resource := range resources {
+		obj := unstructuredFromResourceStatus(resource)
+		obj.SetNamespace(namespace.Name)
+
+		if err := r.Delete(ctx, obj); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			log.Error(err, "Failed to delete resource", "resource", obj, "namespace", namespace.Name)
+			r.Recorder.Eventf(namespaceClass, corev1.EventTypeWarning, "ResourceDeleteFailed", "Failed to delete %s %q in namespace %q: %v", resource.Kind, resource.Name, namespace.Name, err)
+			r.Recorder.Eventf(namespace, corev1.EventTypeWarning, "ResourceDeleteFailed", "Failed to delete %s %q from NamespaceClass %q: %v", resource.Kind, resource.Name, namespaceClass.Name, err)
+			return err
+		}
+		log.Info("Deleted resource", "resource", obj, "namespace", namespace.Name)
+		r.Recorder.Eventf(namespaceClass, corev1.EventTypeNormal, "ResourceDeleted", "Deleted %s %q in namespace %q", resource.Kind, resource.Name, namespace.Name)
+		r.Recorder.Eventf(namespace, corev1.EventTypeNormal, "ResourceDeleted", "Deleted %s %q from NamespaceClass %q", resource.Kind, resource.Name, namespaceClass.Name)
+	}
+	return nil
+}
+
 // Get the namespace
 func (r *NamespaceReconciler) fetchNamespace(ctx context.Context, namespacedName types.NamespacedName, namespace *corev1.Namespace, log logr.Logger) error {
 	if err := r.Get(ctx, namespacedName, namespace); err != nil {
@@ -73,7 +401,15 @@ func (r *NamespaceReconciler) fetchNamespace(ctx context.Context, namespacedName
 
 // Get the NamespaceClass
 func (r *NamespaceReconciler) fetchNamespaceClass(ctx context.Context, classLabel string, namespaceClass *akuityiov1.NamespaceClass, log logr.Logger) error {
-	if err := r.Get(ctx, client.ObjectKey{Name: classLabel}, namespaceClass); err != nil {
+	return fetchNamespaceClass(ctx, r.Client, classLabel, namespaceClass, log)
+}
+
+// fetchNamespaceClass gets a NamespaceClass by name, tolerating NotFound by leaving
+// namespaceClass zero-valued. It is a package-level function rather than a method so that
+// NamespaceClassReconciler can resolve a class's own composition without depending on
+// NamespaceReconciler.
+func fetchNamespaceClass(ctx context.Context, c client.Client, classLabel string, namespaceClass *akuityiov1.NamespaceClass, log logr.Logger) error {
+	if err := c.Get(ctx, client.ObjectKey{Name: classLabel}, namespaceClass); err != nil {
 		if errors.IsNotFound(err) {
 			log.Info("NamespaceClass not found", "namespaceclass", classLabel)
 			return nil
@@ -84,46 +420,50 @@ func (r *NamespaceReconciler) fetchNamespaceClass(ctx context.Context, classLabe
 	return nil
 }
 
-// Handle the creation and updating of resources defined in the NamespaceClass
-func (r *NamespaceReconciler) handleResources(ctx context.Context, namespace *corev1.Namespace, namespaceClass *akuityiov1.NamespaceClass, log logr.Logger) error {
-	for _, resource := range namespaceClass.Spec.Resources {
-		obj := &unstructured.Unstructured{}
-		if err := obj.UnmarshalJSON(resource.Raw); err != nil {
-			log.Error(err, "Failed to unmarshal resource", "resource", resource)
+// Handle the creation and updating of resources in the resolved, effective resource set (the
+// NamespaceClass's own Spec.Resources merged with everything it Extends).
+func (r *NamespaceReconciler) handleResources(ctx context.Context, namespace *corev1.Namespace, namespaceClass *akuityiov1.NamespaceClass, binding *akuityiov1.NamespaceClassBinding, resolvedResources []*unstructured.Unstructured, log logr.Logger) error {
+	for _, resolved := range resolvedResources {
+		obj := resolved.DeepCopy()
+		obj.SetNamespace(namespace.Name)
+		obj.SetOwnerReferences([]metav1.OwnerReference{
+			{
+				APIVersion:         "v1",
+				Kind:               "Namespace",
+				Name:               namespace.Name,
+				UID:                namespace.UID,
+				BlockOwnerDeletion: ptr.To(false),
+			},
+		})
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[managedByLabel] = namespaceClass.Name
+		obj.SetLabels(labels)
+
+		// Server-side apply lets us own only the fields we set, so drift introduced by users or
+		// other controllers on unowned fields is preserved instead of being clobbered.
+		alreadyTracked := r.resourceExistsInBindingStatus(obj, binding)
+		if err := r.Patch(ctx, obj, client.Apply, client.FieldOwner(fieldManager), client.ForceOwnership); err != nil {
+			log.Error(err, "Failed to apply resource", "resource", obj)
+			r.Recorder.Eventf(namespaceClass, corev1.EventTypeWarning, "ResourceApplyFailed", "Failed to apply %s %q in namespace %q: %v", obj.GetKind(), obj.GetName(), namespace.Name, err)
+			r.Recorder.Eventf(namespace, corev1.EventTypeWarning, "ResourceApplyFailed", "Failed to apply %s %q from NamespaceClass %q: %v", obj.GetKind(), obj.GetName(), namespaceClass.Name, err)
 			return err
 		}
-
-		obj.SetNamespace(namespace.Name)
-		key := client.ObjectKeyFromObject(obj)
-		if err := r.Get(ctx, key, obj); err != nil {
-			if errors.IsNotFound(err) {
-				if err := r.Create(ctx, obj); err != nil {
-					log.Error(err, "Failed to create resource", "resource", obj)
-					return err
-				}
-				log.Info("Created resource", "resource", obj)
-
-			} else {
-				log.Error(err, "Failed to get resource", "resource", obj)
-				return err
-			}
+		log.Info("Applied resource", "resource", obj)
+		if alreadyTracked {
+			r.Recorder.Eventf(namespaceClass, corev1.EventTypeNormal, "ResourceUpdated", "Updated %s %q in namespace %q", obj.GetKind(), obj.GetName(), namespace.Name)
 		} else {
-			if err := r.Update(ctx, obj); err != nil {
-				log.Error(err, "Failed to update resource", "resource", obj)
-				return err
-			}
-			log.Info("Updated resource", "resource", obj)
-		}
-
-		// Add the resource in namespaceClass status, if it is not already present
-		if !r.resourceExistsInNamespaceClassStatus(obj, namespaceClass) {
-			namespaceClass.Status.Resources = append(namespaceClass.Status.Resources, akuityiov1.ResourceStatus{
-				Name:       obj.GetName(),
-				APIVersion: obj.GetAPIVersion(),
-				Kind:       obj.GetKind(),
-			})
-			if err := r.Status().Update(ctx, namespaceClass); err != nil {
-				log.Error(err, "Failed to update namespace class status", "namespaceclass", namespaceClass)
+			r.Recorder.Eventf(namespaceClass, corev1.EventTypeNormal, "ResourceCreated", "Created %s %q in namespace %q", obj.GetKind(), obj.GetName(), namespace.Name)
+			r.Recorder.Eventf(namespace, corev1.EventTypeNormal, "ResourceCreated", "Created %s %q from NamespaceClass %q", obj.GetKind(), obj.GetName(), namespaceClass.Name)
+		}
+
+		// Add the resource to the binding status, if it is not already present
+		if !alreadyTracked {
+			binding.Status.Resources = append(binding.Status.Resources, resourceStatusFor(obj))
+			if err := r.Status().Update(ctx, binding); err != nil {
+				log.Error(err, "Failed to update namespace class binding status", "namespace", namespace.Name)
 				return err
 			}
 		}
@@ -131,19 +471,19 @@ func (r *NamespaceReconciler) handleResources(ctx context.Context, namespace *co
 	return nil
 }
 
-// Handles the deletion of resources that are no longer defined in the NamespaceClass.
-// It uses the last-name annotation to check if the NamespaceClass has changed
-func (r *NamespaceReconciler) handleNamespaceAnnotations(ctx context.Context, namespace *corev1.Namespace, namespaceClass *akuityiov1.NamespaceClass, log logr.Logger) error {
+// Handles the deletion of resources that are no longer defined in the effective resource set.
+// It uses the last-label annotation to check if the namespace's class composition has changed.
+func (r *NamespaceReconciler) handleNamespaceAnnotations(ctx context.Context, namespace *corev1.Namespace, classLabel string, namespaceClass *akuityiov1.NamespaceClass, binding *akuityiov1.NamespaceClassBinding, resolvedResources []*unstructured.Unstructured, log logr.Logger) error {
 	if namespace.Annotations == nil {
 		namespace.Annotations = make(map[string]string)
 	}
 
-	if lastName, ok := namespace.Annotations["namespaceclass.akuity.io/last-name"]; !ok {
-		namespace.Annotations["namespaceclass.akuity.io/last-name"] = namespaceClass.Name
-	} else if lastName != namespaceClass.Name {
-		log.Info("Namespace class has changed from " + lastName + " to " + namespaceClass.Name)
-		namespace.Annotations["namespaceclass.akuity.io/last-name"] = namespaceClass.Name
-		if err := r.handleNamespaceClassChange(ctx, namespace, lastName, namespaceClass, log); err != nil {
+	if lastLabel, ok := namespace.Annotations["namespaceclass.akuity.io/last-name"]; !ok {
+		namespace.Annotations["namespaceclass.akuity.io/last-name"] = classLabel
+	} else if lastLabel != classLabel {
+		log.Info("Namespace class has changed from " + lastLabel + " to " + classLabel)
+		namespace.Annotations["namespaceclass.akuity.io/last-name"] = classLabel
+		if err := r.handleNamespaceClassChange(ctx, namespace, lastLabel, resolvedResources, log); err != nil {
 			return err
 		}
 	}
@@ -152,27 +492,31 @@ func (r *NamespaceReconciler) handleNamespaceAnnotations(ctx context.Context, na
 		return err
 	}
 
-	if err := r.handleResourcesDeletion(ctx, namespace, namespaceClass, log); err != nil {
+	if err := r.handleResourcesDeletion(ctx, namespace, namespaceClass, binding, resolvedResources, log); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// Delete resources that are no longer defined in the new NamespaceClass
-func (r *NamespaceReconciler) handleNamespaceClassChange(ctx context.Context, namespace *corev1.Namespace, lastName string, namespaceClass *akuityiov1.NamespaceClass, log logr.Logger) error {
+// Delete resources that were part of the namespace's previous, resolved resource set but are not
+// part of the new one. It diffs against the Status.ResolvedResources of the previous label's
+// last-named (primary) class rather than its raw Spec.Resources, so resources inherited through
+// Extends are pruned correctly too; earlier names in a previous comma-separated label are not
+// consulted here.
+func (r *NamespaceReconciler) handleNamespaceClassChange(ctx context.Context, namespace *corev1.Namespace, lastLabel string, resolvedResources []*unstructured.Unstructured, log logr.Logger) error {
+	oldClassNames := splitClassNames(lastLabel)
+	if len(oldClassNames) == 0 {
+		return nil
+	}
+
 	oldNamespaceClass := &akuityiov1.NamespaceClass{}
-	if err := r.fetchNamespaceClass(ctx, lastName, oldNamespaceClass, log); err != nil {
+	if err := r.fetchNamespaceClass(ctx, oldClassNames[len(oldClassNames)-1], oldNamespaceClass, log); err != nil {
 		return err
 	}
 
-	for _, resource := range oldNamespaceClass.Spec.Resources {
-		obj := &unstructured.Unstructured{}
-		if err := obj.UnmarshalJSON(resource.Raw); err != nil {
-			log.Error(err, "Failed to unmarshal resource", "resource", resource)
-			return err
-		}
-
+	for _, status := range oldNamespaceClass.Status.ResolvedResources {
+		obj := unstructuredFromResourceStatus(status)
 		obj.SetNamespace(namespace.Name)
 		key := client.ObjectKeyFromObject(obj)
 		if err := r.Get(ctx, key, obj); err != nil {
@@ -183,7 +527,7 @@ func (r *NamespaceReconciler) handleNamespaceClassChange(ctx context.Context, na
 			return err
 		}
 
-		if existsInNewNamespaceClass := r.resourceExistsInNamespaceClass(obj, namespaceClass); !existsInNewNamespaceClass {
+		if !resourceExistsIn(resolvedResources, obj) {
 			if err := r.Delete(ctx, obj); err != nil {
 				log.Error(err, "Failed to delete resource", "resource", obj)
 				return err
@@ -194,76 +538,320 @@ func (r *NamespaceReconciler) handleNamespaceClassChange(ctx context.Context, na
 	return nil
 }
 
-// Handle the deletion of resources that are no longer defined in the existing NamespaceClass
-func (r *NamespaceReconciler) handleResourcesDeletion(ctx context.Context, namespace *corev1.Namespace, namespaceClass *akuityiov1.NamespaceClass, log logr.Logger) error {
-	for _, resource := range namespaceClass.Status.Resources {
-		obj := &unstructured.Unstructured{}
+// Handle the deletion of resources that are no longer defined in the effective resource set. A
+// Delete that returns NotFound is tolerated: handleNamespaceClassChange may already have deleted
+// the same object directly against an old class's ResolvedResources, without touching
+// binding.Status.Resources, so this is the step that catches the status entry up.
+func (r *NamespaceReconciler) handleResourcesDeletion(ctx context.Context, namespace *corev1.Namespace, namespaceClass *akuityiov1.NamespaceClass, binding *akuityiov1.NamespaceClassBinding, resolvedResources []*unstructured.Unstructured, log logr.Logger) error {
+	remaining := make([]akuityiov1.ResourceStatus, 0, len(binding.Status.Resources))
+	pruned := false
+	var deleteErr error
+
+	for i, resource := range binding.Status.Resources {
+		obj := unstructuredFromResourceStatus(resource)
 		obj.SetNamespace(namespace.Name)
-		obj.SetAPIVersion(resource.APIVersion)
-		obj.SetKind(resource.Kind)
-		obj.SetName(resource.Name)
 
-		// Check if there is any extra status.resource that is not present in the namespaceClass.spec.resources
-		if !r.resourceExistsInNamespaceClass(obj, namespaceClass) {
-			if err := r.Delete(ctx, obj); err != nil {
-				log.Error(err, "Failed to delete resource", "resource", obj)
-				return err
-			}
+		// Check if there is any extra status.resource that is not present in the resolved set
+		if resourceExistsIn(resolvedResources, obj) {
+			remaining = append(remaining, resource)
+			continue
+		}
+
+		if err := r.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+			log.Error(err, "Failed to delete resource", "resource", obj)
+			r.Recorder.Eventf(namespaceClass, corev1.EventTypeWarning, "ResourceDeleteFailed", "Failed to delete %s %q in namespace %q: %v", obj.GetKind(), obj.GetName(), namespace.Name, err)
+			r.Recorder.Eventf(namespace, corev1.EventTypeWarning, "ResourceDeleteFailed", "Failed to delete %s %q from NamespaceClass %q: %v", obj.GetKind(), obj.GetName(), namespaceClass.Name, err)
+			// Keep this entry and everything not yet processed, then stop; a genuine delete
+			// failure shouldn't be mistaken for success and dropped from status.
+			remaining = append(remaining, binding.Status.Resources[i:]...)
+			deleteErr = err
+			break
+		} else if err == nil {
 			log.Info("Deleted resource", "resource", obj)
+			r.Recorder.Eventf(namespaceClass, corev1.EventTypeNormal, "ResourceDeleted", "Deleted %s %q in namespace %q", obj.GetKind(), obj.GetName(), namespace.Name)
+			r.Recorder.Eventf(namespace, corev1.EventTypeNormal, "ResourceDeleted", "Deleted %s %q from NamespaceClass %q", obj.GetKind(), obj.GetName(), namespaceClass.Name)
+		}
+		// A NotFound delete falls through here too: the object is already gone (most likely
+		// removed directly by handleNamespaceClassChange), so the status entry is still stale
+		// and should be pruned.
 
-			// Remove the resource from namespaceClass status
-			for i, statusResource := range namespaceClass.Status.Resources {
-				if statusResource.Name == obj.GetName() && statusResource.APIVersion == obj.GetAPIVersion() && statusResource.Kind == obj.GetKind() {
-					namespaceClass.Status.Resources = append(namespaceClass.Status.Resources[:i], namespaceClass.Status.Resources[i+1:]...)
-					if err := r.Status().Update(ctx, namespaceClass); err != nil {
-						log.Error(err, "Failed to update namespace class status", "namespaceclass", namespaceClass)
-						return err
-					}
-				}
-			}
+		pruned = true
+	}
+
+	if pruned {
+		binding.Status.Resources = remaining
+		if err := r.Status().Update(ctx, binding); err != nil {
+			log.Error(err, "Failed to update namespace class binding status", "namespace", namespace.Name)
+			return err
 		}
 	}
-	return nil
+
+	return deleteErr
 }
 
-// Check if the resource exists in the NamespaceClass status
-func (r *NamespaceReconciler) resourceExistsInNamespaceClassStatus(obj *unstructured.Unstructured, namespaceClass *akuityiov1.NamespaceClass) bool {
-	for _, resource := range namespaceClass.Status.Resources {
-		if resource.Name == obj.GetName() && resource.APIVersion == obj.GetAPIVersion() && resource.Kind == obj.GetKind() {
+// Check if the resource exists in the NamespaceClassBinding status
+func (r *NamespaceReconciler) resourceExistsInBindingStatus(obj *unstructured.Unstructured, binding *akuityiov1.NamespaceClassBinding) bool {
+	for _, resource := range binding.Status.Resources {
+		if resourceStatusMatches(resource, obj) {
 			return true
 		}
 	}
 	return false
 }
 
-// Check if the same resource exists in the new NamespaceClass
-func (r *NamespaceReconciler) resourceExistsInNamespaceClass(obj *unstructured.Unstructured, namespaceClass *akuityiov1.NamespaceClass) bool {
-	for _, newResource := range namespaceClass.Spec.Resources {
-		newObj := &unstructured.Unstructured{}
-		if err := newObj.UnmarshalJSON(newResource.Raw); err != nil {
-			return false
-		}
-		if newObj.GroupVersionKind() == obj.GroupVersionKind() {
+// resourceExistsIn reports whether a resource with the same GVK and name as obj appears in
+// resources, keyed the same way ResourceStatus is so that two resources of the same Kind are
+// never conflated.
+func resourceExistsIn(resources []*unstructured.Unstructured, obj *unstructured.Unstructured) bool {
+	for _, candidate := range resources {
+		if candidate.GroupVersionKind() == obj.GroupVersionKind() && candidate.GetName() == obj.GetName() {
 			return true
 		}
 	}
 	return false
 }
 
+// splitClassNames parses a namespace's `namespaceclass.akuity.io/name` label value into an
+// ordered list of NamespaceClass names. A plain name yields a single-element list; a
+// comma-separated value composes multiple classes, resolved in order so later names override
+// earlier ones, the same way a class's own Extends does.
+func splitClassNames(label string) []string {
+	var names []string
+	for _, name := range strings.Split(label, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// classClosure walks the NamespaceClass inheritance graph rooted at classNames (in order, the same
+// comma-separated list a namespace's class label carries) and returns every class name reachable
+// through Extends, deduplicated. Classes that don't exist are skipped rather than erroring here,
+// since callers that need strict validation already get that from resolveEffectiveResources.
+func (r *NamespaceReconciler) classClosure(ctx context.Context, classNames []string, log logr.Logger) ([]string, error) {
+	visited := map[string]bool{}
+	var closure []string
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		if visited[name] {
+			return nil
+		}
+		visited[name] = true
+
+		class := &akuityiov1.NamespaceClass{}
+		if err := r.fetchNamespaceClass(ctx, name, class, log); err != nil {
+			return err
+		}
+		if class.Name == "" {
+			return nil
+		}
+		closure = append(closure, name)
+
+		for _, parent := range class.Spec.Extends {
+			if err := visit(parent); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, name := range classNames {
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+	return closure, nil
+}
+
+// ensureClassFinalizers installs namespaceClassFinalizer on every class in classNames' full
+// Extends closure, not just the primary class, so deleting a parent/extended class is blocked
+// until its dependents have been untangled the same way deleting the primary class already is.
+func (r *NamespaceReconciler) ensureClassFinalizers(ctx context.Context, classNames []string, log logr.Logger) error {
+	closure, err := r.classClosure(ctx, classNames, log)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range closure {
+		class := &akuityiov1.NamespaceClass{}
+		if err := r.fetchNamespaceClass(ctx, name, class, log); err != nil {
+			return err
+		}
+		if class.Name == "" || !class.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if controllerutil.AddFinalizer(class, namespaceClassFinalizer) {
+			if err := r.Update(ctx, class); err != nil {
+				log.Error(err, "Failed to add finalizer to namespace class", "namespaceclass", class.Name)
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// namespaceDependsOnClass reports whether a namespace's class label names className directly or
+// reaches it transitively through some class's Extends, so changes to (or deletion of) a parent
+// class are recognized as relevant to namespaces that only reference a child of it.
+func (r *NamespaceReconciler) namespaceDependsOnClass(ctx context.Context, namespace *corev1.Namespace, className string, log logr.Logger) (bool, error) {
+	classLabel, ok := namespace.Labels["namespaceclass.akuity.io/name"]
+	if !ok {
+		return false, nil
+	}
+	closure, err := r.classClosure(ctx, splitClassNames(classLabel), log)
+	if err != nil {
+		return false, err
+	}
+	for _, name := range closure {
+		if name == className {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// resolveEffectiveResources walks the NamespaceClass inheritance graph rooted at classNames (in
+// order) and returns the flattened, merged resource set to apply. Each class's parents (Extends)
+// are resolved before the class itself, and resources are merged by GVK+name with later entries -
+// a class's own Resources over its parents', and later names in classNames over earlier ones -
+// overriding earlier ones. A cycle anywhere in the graph is reported as an error.
+func (r *NamespaceReconciler) resolveEffectiveResources(ctx context.Context, classNames []string, log logr.Logger) ([]*unstructured.Unstructured, error) {
+	return resolveEffectiveResources(ctx, r.Client, classNames, log)
+}
+
+// resolveEffectiveResources is the package-level form of the method above, parameterized on a
+// client.Client so NamespaceClassReconciler can resolve a class's own composition (classNames of
+// just that one class's name) without depending on NamespaceReconciler.
+func resolveEffectiveResources(ctx context.Context, c client.Client, classNames []string, log logr.Logger) ([]*unstructured.Unstructured, error) {
+	visiting := map[string]bool{}
+	visited := map[string]bool{}
+	index := map[string]int{}
+	var merged []*unstructured.Unstructured
+
+	var resolve func(name string) error
+	resolve = func(name string) error {
+		if visited[name] {
+			return nil
+		}
+		if visiting[name] {
+			return fmt.Errorf("cycle detected in NamespaceClass inheritance at %q", name)
+		}
+		visiting[name] = true
+		defer delete(visiting, name)
+
+		class := &akuityiov1.NamespaceClass{}
+		if err := fetchNamespaceClass(ctx, c, name, class, log); err != nil {
+			return err
+		}
+		if class.Name == "" {
+			return fmt.Errorf("NamespaceClass %q does not exist", name)
+		}
+
+		for _, parent := range class.Spec.Extends {
+			if err := resolve(parent); err != nil {
+				return err
+			}
+		}
+
+		for _, resource := range class.Spec.Resources {
+			obj := &unstructured.Unstructured{}
+			if err := obj.UnmarshalJSON(resource.Raw); err != nil {
+				return fmt.Errorf("unmarshal resource in NamespaceClass %q: %w", name, err)
+			}
+			key := obj.GroupVersionKind().String() + "/" + obj.GetName()
+			if i, ok := index[key]; ok {
+				merged[i] = obj
+			} else {
+				index[key] = len(merged)
+				merged = append(merged, obj)
+			}
+		}
+
+		visited[name] = true
+		return nil
+	}
+
+	for _, name := range classNames {
+		if err := resolve(name); err != nil {
+			return nil, err
+		}
+	}
+
+	return merged, nil
+}
+
+// resourceStatusesFor converts a resolved resource set into the identity-only status entries
+// persisted in Status.ResolvedResources. The set is a property of the class's own composition, so
+// entries carry no namespace.
+func resourceStatusesFor(resources []*unstructured.Unstructured) []akuityiov1.ResourceStatus {
+	statuses := make([]akuityiov1.ResourceStatus, 0, len(resources))
+	for _, obj := range resources {
+		gvk := obj.GroupVersionKind()
+		statuses = append(statuses, akuityiov1.ResourceStatus{
+			Group:   gvk.Group,
+			Version: gvk.Version,
+			Kind:    gvk.Kind,
+			Name:    obj.GetName(),
+		})
+	}
+	return statuses
+}
+
+// resourceStatusFor builds the status entry that uniquely identifies a live resource.
+func resourceStatusFor(obj *unstructured.Unstructured) akuityiov1.ResourceStatus {
+	gvk := obj.GroupVersionKind()
+	return akuityiov1.ResourceStatus{
+		Group:     gvk.Group,
+		Version:   gvk.Version,
+		Kind:      gvk.Kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}
+}
+
+// resourceStatusMatches reports whether a status entry identifies the given object.
+func resourceStatusMatches(status akuityiov1.ResourceStatus, obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	return status.Group == gvk.Group && status.Version == gvk.Version && status.Kind == gvk.Kind &&
+		status.Namespace == obj.GetNamespace() && status.Name == obj.GetName()
+}
+
+// unstructuredFromResourceStatus reconstructs a minimal object reference from a status entry, for
+// Get/Delete calls that only need the resource's identity.
+func unstructuredFromResourceStatus(status akuityiov1.ResourceStatus) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.GroupVersionKind{Group: status.Group, Version: status.Version, Kind: status.Kind})
+	obj.SetNamespace(status.Namespace)
+	obj.SetName(status.Name)
+	return obj
+}
+
 func (r *NamespaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&corev1.Namespace{}).
+		For(&corev1.Namespace{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			namespace, ok := obj.(*corev1.Namespace)
+			if !ok {
+				return false
+			}
+			// A namespace that still carries our finalizer must keep reconciling even if it has
+			// drifted out of scope (e.g. its labels changed), or its own deletion event would be
+			// filtered out here and it would be stuck in Terminating forever.
+			if controllerutil.ContainsFinalizer(namespace, namespaceClassFinalizer) {
+				return true
+			}
+			return r.inScope(namespace)
+		}))).
 		Watches(&akuityiov1.NamespaceClass{},
-			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, o client.Object) []reconcile.Request {
-				return r.mapNamespaceClassToNamespaces(o)
-			}),
+			handler.EnqueueRequestsFromMapFunc(r.mapNamespaceClassToNamespaces),
 		).
 		Complete(r)
 }
 
 // mapNamespaceClassToNamespaces maps a NamespaceClass to a list of Namespaces that use it.
-func (r *NamespaceReconciler) mapNamespaceClassToNamespaces(obj client.Object) []reconcile.Request {
+func (r *NamespaceReconciler) mapNamespaceClassToNamespaces(ctx context.Context, obj client.Object) []reconcile.Request {
 	var reqs []reconcile.Request
 
 	// Get the NamespaceClass from the object
@@ -275,19 +863,43 @@ func (r *NamespaceReconciler) mapNamespaceClassToNamespaces(obj client.Object) [
 
 	// List all Namespace resources
 	var namespaces corev1.NamespaceList
-	if err := r.List(context.Background(), &namespaces); err != nil {
+	if err := r.List(ctx, &namespaces); err != nil {
 		// handle error
 		return nil
 	}
 
-	// Iterate over the namespaces and enqueue a request for each namespace that uses the NamespaceClass
-	for _, ns := range namespaces.Items {
-		if ns.Labels["namespaceclass.akuity.io/name"] == namespaceClass.Name {
-			reqs = append(reqs, reconcile.Request{
-				NamespacedName: types.NamespacedName{
-					Name: ns.Name,
-				},
-			})
+	// Iterate over the namespaces and enqueue a request for each in-scope namespace that depends
+	// on the NamespaceClass, directly or transitively through some class's Extends, so editing a
+	// parent class re-enqueues namespaces that only reference a child of it. Out-of-scope
+	// namespaces are skipped so a class change never pulls in namespaces owned by a different
+	// nsc-controller deployment.
+	for i := range namespaces.Items {
+		ns := &namespaces.Items[i]
+		depends, err := r.namespaceDependsOnClass(ctx, ns, namespaceClass.Name, log.FromContext(ctx))
+		if err != nil {
+			log.FromContext(ctx).Error(err, "Failed to resolve namespace class dependency", "namespace", ns.Name)
+			continue
+		}
+		if !depends {
+			continue
+		}
+		if !r.inScope(ns) {
+			continue
+		}
+		reqs = append(reqs, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name: ns.Name,
+			},
+		})
+	}
+
+	// A class with no bound namespaces never routes through Reconcile, so a deleted class with
+	// nothing left to tear down would otherwise keep its finalizer forever.
+	if len(reqs) == 0 && !namespaceClass.DeletionTimestamp.IsZero() {
+		if controllerutil.RemoveFinalizer(namespaceClass, namespaceClassFinalizer) {
+			if err := r.Update(ctx, namespaceClass); err != nil {
+				log.FromContext(ctx).Error(err, "Failed to remove finalizer from unbound namespace class", "namespaceclass", namespaceClass.Name)
+			}
 		}
 	}
 
@@ -0,0 +1,320 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	akuityiov1 "github.com/anubhav06/nsc-controller/api/v1"
+)
+
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add corev1 to scheme: %v", err)
+	}
+	if err := akuityiov1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add akuityiov1 to scheme: %v", err)
+	}
+	return scheme
+}
+
+func configMap(name string, data map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetName(name)
+	obj.Object["data"] = data
+	return obj
+}
+
+func rawExtension(t *testing.T, obj *unstructured.Unstructured) runtime.RawExtension {
+	t.Helper()
+	data, err := obj.MarshalJSON()
+	if err != nil {
+		t.Fatalf("failed to marshal object: %v", err)
+	}
+	return runtime.RawExtension{Raw: data}
+}
+
+// TestHandleResources_GVKCollision verifies that two resources of the same Kind in a
+// NamespaceClass are tracked as distinct status entries instead of one being mistaken for the
+// other.
+func TestHandleResources_GVKCollision(t *testing.T) {
+	scheme := newTestScheme(t)
+
+	namespaceClass := &akuityiov1.NamespaceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "two-configmaps"},
+		Spec: akuityiov1.NamespaceClassSpec{
+			Resources: []runtime.RawExtension{
+				rawExtension(t, configMap("team-config", map[string]interface{}{"k": "v1"})),
+				rawExtension(t, configMap("shared-config", map[string]interface{}{"k": "v2"})),
+			},
+		},
+	}
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", UID: "ns-uid"}}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(namespaceClass, namespace).WithStatusSubresource(&akuityiov1.NamespaceClass{}, &akuityiov1.NamespaceClassBinding{}).Build()
+	r := &NamespaceReconciler{Client: fakeClient, Scheme: scheme, Recorder: record.NewFakeRecorder(10)}
+	ctx := log.IntoContext(context.Background(), ctrl.Log)
+
+	resolvedResources, err := r.resolveEffectiveResources(ctx, []string{namespaceClass.Name}, log.FromContext(ctx))
+	if err != nil {
+		t.Fatalf("resolveEffectiveResources returned error: %v", err)
+	}
+
+	binding := &akuityiov1.NamespaceClassBinding{ObjectMeta: metav1.ObjectMeta{Namespace: namespace.Name, Name: namespaceClassBindingName}, Spec: akuityiov1.NamespaceClassBindingSpec{ClassName: namespaceClass.Name}}
+	if err := fakeClient.Create(ctx, binding); err != nil {
+		t.Fatalf("failed to create namespace class binding: %v", err)
+	}
+
+	if err := r.handleResources(ctx, namespace, namespaceClass, binding, resolvedResources, log.FromContext(ctx)); err != nil {
+		t.Fatalf("handleResources returned error: %v", err)
+	}
+
+	if len(binding.Status.Resources) != 2 {
+		t.Fatalf("expected 2 tracked resources, got %d: %+v", len(binding.Status.Resources), binding.Status.Resources)
+	}
+
+	names := map[string]bool{}
+	for _, resource := range binding.Status.Resources {
+		if resource.Kind != "ConfigMap" {
+			t.Fatalf("unexpected kind tracked: %s", resource.Kind)
+		}
+		names[resource.Name] = true
+	}
+	if !names["team-config"] || !names["shared-config"] {
+		t.Fatalf("expected both config maps to be tracked by name, got %+v", binding.Status.Resources)
+	}
+
+	for _, name := range []string{"team-config", "shared-config"} {
+		obj := &corev1.ConfigMap{}
+		if err := fakeClient.Get(ctx, client.ObjectKey{Namespace: "team-a", Name: name}, obj); err != nil {
+			t.Fatalf("expected ConfigMap %q to be created: %v", name, err)
+		}
+	}
+}
+
+// TestHandleResources_DriftReconciliation verifies that fields set by another field manager are
+// preserved across repeated applies, while fields owned by this controller still converge to the
+// NamespaceClass spec.
+func TestHandleResources_DriftReconciliation(t *testing.T) {
+	scheme := newTestScheme(t)
+
+	cm := configMap("team-config", map[string]interface{}{"owned-key": "v1"})
+	namespaceClass := &akuityiov1.NamespaceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "base"},
+		Spec: akuityiov1.NamespaceClassSpec{
+			Resources: []runtime.RawExtension{rawExtension(t, cm)},
+		},
+	}
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", UID: "ns-uid"}}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(namespaceClass, namespace).WithStatusSubresource(&akuityiov1.NamespaceClass{}, &akuityiov1.NamespaceClassBinding{}).Build()
+	r := &NamespaceReconciler{Client: fakeClient, Scheme: scheme, Recorder: record.NewFakeRecorder(10)}
+	ctx := log.IntoContext(context.Background(), ctrl.Log)
+
+	binding := &akuityiov1.NamespaceClassBinding{ObjectMeta: metav1.ObjectMeta{Namespace: namespace.Name, Name: namespaceClassBindingName}, Spec: akuityiov1.NamespaceClassBindingSpec{ClassName: namespaceClass.Name}}
+	if err := fakeClient.Create(ctx, binding); err != nil {
+		t.Fatalf("failed to create namespace class binding: %v", err)
+	}
+
+	resolvedResources, err := r.resolveEffectiveResources(ctx, []string{namespaceClass.Name}, log.FromContext(ctx))
+	if err != nil {
+		t.Fatalf("resolveEffectiveResources returned error: %v", err)
+	}
+	if err := r.handleResources(ctx, namespace, namespaceClass, binding, resolvedResources, log.FromContext(ctx)); err != nil {
+		t.Fatalf("initial handleResources returned error: %v", err)
+	}
+
+	// Simulate drift: another actor adds a key the controller doesn't own.
+	live := &corev1.ConfigMap{}
+	if err := fakeClient.Get(ctx, client.ObjectKey{Namespace: "team-a", Name: "team-config"}, live); err != nil {
+		t.Fatalf("failed to get created ConfigMap: %v", err)
+	}
+	if live.Data == nil {
+		live.Data = map[string]string{}
+	}
+	live.Data["operator-added-key"] = "manual"
+	if err := fakeClient.Update(ctx, live); err != nil {
+		t.Fatalf("failed to simulate drift update: %v", err)
+	}
+
+	// Bump the owned field in the spec and reconcile again.
+	cm = configMap("team-config", map[string]interface{}{"owned-key": "v2"})
+	namespaceClass.Spec.Resources = []runtime.RawExtension{rawExtension(t, cm)}
+	if err := fakeClient.Update(ctx, namespaceClass); err != nil {
+		t.Fatalf("failed to bump namespace class spec: %v", err)
+	}
+
+	resolvedResources, err = r.resolveEffectiveResources(ctx, []string{namespaceClass.Name}, log.FromContext(ctx))
+	if err != nil {
+		t.Fatalf("resolveEffectiveResources returned error: %v", err)
+	}
+	if err := r.handleResources(ctx, namespace, namespaceClass, binding, resolvedResources, log.FromContext(ctx)); err != nil {
+		t.Fatalf("second handleResources returned error: %v", err)
+	}
+
+	result := &corev1.ConfigMap{}
+	if err := fakeClient.Get(ctx, client.ObjectKey{Namespace: "team-a", Name: "team-config"}, result); err != nil {
+		t.Fatalf("failed to get reconciled ConfigMap: %v", err)
+	}
+	if result.Data["owned-key"] != "v2" {
+		t.Fatalf("expected owned-key to converge to v2, got %q", result.Data["owned-key"])
+	}
+	if result.Data["operator-added-key"] != "manual" {
+		t.Fatalf("expected drifted key to survive reconciliation, got %+v", result.Data)
+	}
+}
+
+// TestResolveEffectiveResources_ChildOverridesParent verifies that a child class's resource
+// overrides a parent's entry of the same GVK+name, while resources unique to the parent still
+// flow through.
+func TestResolveEffectiveResources_ChildOverridesParent(t *testing.T) {
+	scheme := newTestScheme(t)
+
+	base := &akuityiov1.NamespaceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "base"},
+		Spec: akuityiov1.NamespaceClassSpec{
+			Resources: []runtime.RawExtension{
+				rawExtension(t, configMap("shared-config", map[string]interface{}{"tier": "base"})),
+				rawExtension(t, configMap("base-only", map[string]interface{}{"k": "v"})),
+			},
+		},
+	}
+	child := &akuityiov1.NamespaceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+		Spec: akuityiov1.NamespaceClassSpec{
+			Extends:   []string{"base"},
+			Resources: []runtime.RawExtension{rawExtension(t, configMap("shared-config", map[string]interface{}{"tier": "team-a"}))},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(base, child).Build()
+	r := &NamespaceReconciler{Client: fakeClient, Scheme: scheme}
+	ctx := log.IntoContext(context.Background(), ctrl.Log)
+
+	resolved, err := r.resolveEffectiveResources(ctx, []string{"team-a"}, log.FromContext(ctx))
+	if err != nil {
+		t.Fatalf("resolveEffectiveResources returned error: %v", err)
+	}
+	if len(resolved) != 2 {
+		t.Fatalf("expected 2 resolved resources, got %d: %+v", len(resolved), resolved)
+	}
+
+	byName := map[string]*unstructured.Unstructured{}
+	for _, obj := range resolved {
+		byName[obj.GetName()] = obj
+	}
+	if byName["base-only"] == nil {
+		t.Fatalf("expected base-only resource to flow through, got %+v", resolved)
+	}
+	shared := byName["shared-config"]
+	if shared == nil {
+		t.Fatalf("expected shared-config resource, got %+v", resolved)
+	}
+	if tier, _, _ := unstructured.NestedString(shared.Object, "data", "tier"); tier != "team-a" {
+		t.Fatalf("expected child's shared-config to override parent's, got tier=%q", tier)
+	}
+}
+
+// TestResolveEffectiveResources_CycleDetected verifies that a cycle in Extends is reported as an
+// error instead of recursing forever.
+func TestResolveEffectiveResources_CycleDetected(t *testing.T) {
+	scheme := newTestScheme(t)
+
+	a := &akuityiov1.NamespaceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "a"},
+		Spec:       akuityiov1.NamespaceClassSpec{Extends: []string{"b"}, Resources: []runtime.RawExtension{rawExtension(t, configMap("cm", nil))}},
+	}
+	b := &akuityiov1.NamespaceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "b"},
+		Spec:       akuityiov1.NamespaceClassSpec{Extends: []string{"a"}, Resources: []runtime.RawExtension{rawExtension(t, configMap("cm", nil))}},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(a, b).Build()
+	r := &NamespaceReconciler{Client: fakeClient, Scheme: scheme}
+	ctx := log.IntoContext(context.Background(), ctrl.Log)
+
+	if _, err := r.resolveEffectiveResources(ctx, []string{"a"}, log.FromContext(ctx)); err == nil {
+		t.Fatal("expected cycle detection error, got nil")
+	}
+}
+
+// TestHandleNamespaceAnnotations_ClassChangePrunesStaleBindingResource verifies that when a
+// namespace's class label changes, a resource left over from the old class - already deleted
+// directly by handleNamespaceClassChange - is still pruned from binding.Status.Resources by
+// handleResourcesDeletion instead of wedging on a NotFound delete of the same object.
+func TestHandleNamespaceAnnotations_ClassChangePrunesStaleBindingResource(t *testing.T) {
+	scheme := newTestScheme(t)
+
+	oldClass := &akuityiov1.NamespaceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "old"},
+		Status: akuityiov1.NamespaceClassStatus{
+			ResolvedResources: []akuityiov1.ResourceStatus{
+				{Version: "v1", Kind: "ConfigMap", Name: "old-cm"},
+			},
+		},
+	}
+	newClass := &akuityiov1.NamespaceClass{ObjectMeta: metav1.ObjectMeta{Name: "new"}}
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "team-a",
+			UID:  "ns-uid",
+			Annotations: map[string]string{
+				"namespaceclass.akuity.io/last-name": "old",
+			},
+		},
+	}
+	staleConfigMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace.Name, Name: "old-cm"}}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(oldClass, newClass, namespace, staleConfigMap).
+		WithStatusSubresource(&akuityiov1.NamespaceClass{}, &akuityiov1.NamespaceClassBinding{}).
+		Build()
+	r := &NamespaceReconciler{Client: fakeClient, Scheme: scheme, Recorder: record.NewFakeRecorder(10)}
+	ctx := log.IntoContext(context.Background(), ctrl.Log)
+
+	binding := &akuityiov1.NamespaceClassBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace.Name, Name: namespaceClassBindingName},
+		Spec:       akuityiov1.NamespaceClassBindingSpec{ClassName: "new"},
+	}
+	if err := fakeClient.Create(ctx, binding); err != nil {
+		t.Fatalf("failed to create namespace class binding: %v", err)
+	}
+	binding.Status.Resources = []akuityiov1.ResourceStatus{
+		{Version: "v1", Kind: "ConfigMap", Namespace: namespace.Name, Name: "old-cm"},
+	}
+	if err := fakeClient.Status().Update(ctx, binding); err != nil {
+		t.Fatalf("failed to seed namespace class binding status: %v", err)
+	}
+
+	// The new class resolves to no resources, so the ConfigMap inherited from "old" is stale.
+	if err := r.handleNamespaceAnnotations(ctx, namespace, "new", newClass, binding, nil, log.FromContext(ctx)); err != nil {
+		t.Fatalf("handleNamespaceAnnotations returned error: %v", err)
+	}
+
+	obj := &corev1.ConfigMap{}
+	if err := fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace.Name, Name: "old-cm"}, obj); err == nil || !errors.IsNotFound(err) {
+		t.Fatalf("expected stale ConfigMap to already be deleted, got err=%v", err)
+	}
+
+	updatedBinding := &akuityiov1.NamespaceClassBinding{}
+	if err := fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace.Name, Name: namespaceClassBindingName}, updatedBinding); err != nil {
+		t.Fatalf("failed to get namespace class binding: %v", err)
+	}
+	if len(updatedBinding.Status.Resources) != 0 {
+		t.Fatalf("expected stale resource to be pruned from binding status, got %+v", updatedBinding.Status.Resources)
+	}
+}
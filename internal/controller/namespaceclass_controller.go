@@ -0,0 +1,138 @@
+package controller
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akuityiov1 "github.com/anubhav06/nsc-controller/api/v1"
+)
+
+// NamespaceClassReconciler aggregates the NamespaceClassBindings bound to a NamespaceClass into
+// that class's Status, so per-namespace reconciles never contend on a single shared status object.
+type NamespaceClassReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+//+kubebuilder:rbac:groups=akuity.io.my.domain,resources=namespaceclasses,verbs=get;list;watch
+//+kubebuilder:rbac:groups=akuity.io.my.domain,resources=namespaceclasses/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=akuity.io.my.domain,resources=namespaceclassbindings,verbs=get;list;watch
+
+func (r *NamespaceClassReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	namespaceClass := &akuityiov1.NamespaceClass{}
+	if err := r.Get(ctx, req.NamespacedName, namespaceClass); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !namespaceClass.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	var bindings akuityiov1.NamespaceClassBindingList
+	if err := r.List(ctx, &bindings); err != nil {
+		log.Error(err, "Failed to list namespace class bindings", "namespaceclass", namespaceClass.Name)
+		return ctrl.Result{}, err
+	}
+
+	var bound []akuityiov1.NamespaceClassBinding
+	for _, binding := range bindings.Items {
+		if binding.Spec.ClassName == namespaceClass.Name {
+			bound = append(bound, binding)
+		}
+	}
+	sort.Slice(bound, func(i, j int) bool { return bound[i].Namespace < bound[j].Namespace })
+
+	base := client.MergeFrom(namespaceClass.DeepCopy())
+
+	summaries := make([]akuityiov1.NamespaceSummary, 0, len(bound))
+	for _, binding := range bound {
+		summaries = append(summaries, akuityiov1.NamespaceSummary{
+			Namespace:     binding.Namespace,
+			ResourceCount: len(binding.Status.Resources),
+			Ready:         apimeta.IsStatusConditionTrue(binding.Status.Conditions, akuityiov1.ConditionTypeReady),
+		})
+	}
+	namespaceClass.Status.BoundNamespaces = len(bound)
+	namespaceClass.Status.NamespaceSummaries = summaries
+
+	for _, conditionType := range []string{akuityiov1.ConditionTypeReady, akuityiov1.ConditionTypeResourcesApplied, akuityiov1.ConditionTypeResourcesPruned} {
+		apimeta.SetStatusCondition(&namespaceClass.Status.Conditions, aggregateCondition(conditionType, bound))
+	}
+
+	// ResolvedResources reflects this class's own composition (its Extends chain resolved against
+	// itself), not any one namespace's comma-list of classes, so it's computed here rather than by
+	// NamespaceReconciler - keeping this the single writer of NamespaceClass.Status.
+	resolved, err := resolveEffectiveResources(ctx, r.Client, []string{namespaceClass.Name}, log)
+	if err != nil {
+		log.Error(err, "Failed to resolve NamespaceClass's own inheritance", "namespaceclass", namespaceClass.Name)
+	} else {
+		namespaceClass.Status.ResolvedResources = resourceStatusesFor(resolved)
+	}
+	namespaceClass.Status.ObservedGeneration = namespaceClass.Generation
+
+	if err := r.Status().Patch(ctx, namespaceClass, base); err != nil {
+		log.Error(err, "Failed to patch namespace class aggregate status", "namespaceclass", namespaceClass.Name)
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// aggregateCondition rolls up a condition type across every bound NamespaceClassBinding: True only
+// if every binding reports True for it, vacuously True when nothing is bound yet.
+func aggregateCondition(conditionType string, bound []akuityiov1.NamespaceClassBinding) metav1.Condition {
+	var notReady []string
+	for _, binding := range bound {
+		if !apimeta.IsStatusConditionTrue(binding.Status.Conditions, conditionType) {
+			notReady = append(notReady, binding.Namespace)
+		}
+	}
+
+	if len(notReady) == 0 {
+		return metav1.Condition{
+			Type:    conditionType,
+			Status:  metav1.ConditionTrue,
+			Reason:  "AllNamespacesReady",
+			Message: "Every bound namespace reports this condition as True",
+		}
+	}
+	return metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionFalse,
+		Reason:  "NamespacesNotReady",
+		Message: "Namespaces not reporting this condition as True: " + strings.Join(notReady, ", "),
+	}
+}
+
+func (r *NamespaceClassReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&akuityiov1.NamespaceClass{}).
+		Watches(&akuityiov1.NamespaceClassBinding{},
+			handler.EnqueueRequestsFromMapFunc(r.mapBindingToNamespaceClass),
+		).
+		Complete(r)
+}
+
+// mapBindingToNamespaceClass maps a NamespaceClassBinding to a reconcile request for the
+// NamespaceClass it is bound to.
+func (r *NamespaceClassReconciler) mapBindingToNamespaceClass(_ context.Context, obj client.Object) []reconcile.Request {
+	binding, ok := obj.(*akuityiov1.NamespaceClassBinding)
+	if !ok || binding.Spec.ClassName == "" {
+		return nil
+	}
+	return []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Name: binding.Spec.ClassName}},
+	}
+}